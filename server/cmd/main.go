@@ -14,10 +14,26 @@ func main() {
 
 	// Расписание уроков
 	sc := handlers.ScheduleHandlers{
-		Provider: provider.DebugProvider{},
+		Provider: p,
 	}
 	app.Get("/sc/week", sc.GetWeek)
+	app.Get("/sc/today", sc.GetToday)
 	app.Get("/sc/status", sc.GetStatus)
+
+	events := handlers.EventsHandlers{Bus: p.Bus()}
+	app.Get("/sc/events", events.GetEvents)
+
+	// Экспорт расписания в iCalendar/CSV
+	export := handlers.ExportHandlers{Provider: p, Bells: p.Bells()}
+	app.Get("/sc/week.ics", export.GetWeekICS)
+	app.Get("/sc/week.csv", export.GetWeekCSV)
+	app.Get("/sc/:day.ics", export.GetDayICS)
+	app.Get("/sc/:day.csv", export.GetDayCSV)
+
+	// /sc/:day регистрируем последним из всех /sc/... маршрутов: как
+	// последний сегмент пути, он матчится жадно до ближайшего "/" и иначе
+	// перехватывает на себя более специфичные маршруты вроде /sc/events
+	// или /sc/week.ics.
 	app.Get("/sc/:day", sc.GetDay)
 
 	// Общая информация
@@ -0,0 +1,53 @@
+// Экспорт расписания в CSV (RFC 4180)
+
+package export
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"splatorm/provider"
+)
+
+var csvHeader = []string{"date", "group", "period", "lesson", "cabinet"}
+
+// DayCSV сериализует расписание одного дня в CSV, по одной строке на урок.
+func DayCSV(day *provider.DaySchedule, date time.Time) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write(csvHeader)
+	writeDayRows(w, day, date)
+	w.Flush()
+	return b.String()
+}
+
+// WeekCSV сериализует Schedule целиком, по одной строке на урок.
+func WeekCSV(week *provider.Schedule, from time.Time) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write(csvHeader)
+
+	monday := weekStart(from)
+	for i, day := range *week {
+		writeDayRows(w, &day, monday.AddDate(0, 0, i))
+	}
+
+	w.Flush()
+	return b.String()
+}
+
+func writeDayRows(w *csv.Writer, day *provider.DaySchedule, date time.Time) {
+	for group, lessons := range *day {
+		for _, lesson := range lessons {
+			w.Write([]string{
+				date.Format(time.DateOnly),
+				group,
+				strconv.Itoa(lesson.Period),
+				lesson.Name,
+				lesson.Cabinet,
+			})
+		}
+	}
+}
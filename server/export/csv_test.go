@@ -0,0 +1,32 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"splatorm/provider"
+)
+
+func TestWeekCSVPlacesFirstDayOnMondayEvenFromSunday(t *testing.T) {
+	sunday := time.Date(2026, time.July, 26, 12, 0, 0, 0, time.UTC)
+
+	week := provider.Schedule{
+		provider.DaySchedule{"9A": {{Name: "Math", Cabinet: "101", Period: 1}}},
+	}
+
+	out := WeekCSV(&week, sunday)
+
+	if !strings.Contains(out, "2026-07-20,9A,1,Math,101") {
+		t.Fatalf("expected the single day to land on 2026-07-20 (Monday), got:\n%s", out)
+	}
+}
+
+func TestDayCSVHeader(t *testing.T) {
+	day := provider.DaySchedule{}
+	out := DayCSV(&day, time.Now())
+
+	if !strings.HasPrefix(out, "date,group,period,lesson,cabinet\n") {
+		t.Fatalf("expected a CSV header row, got:\n%s", out)
+	}
+}
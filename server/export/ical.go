@@ -0,0 +1,84 @@
+// Экспорт расписания в iCalendar (RFC 5545)
+
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"splatorm/provider"
+)
+
+const icsDateTimeLayout = "20060102T150405"
+
+// Day сериализует расписание одного дня в VEVENT-блоки на указанную дату.
+func Day(day *provider.DaySchedule, date time.Time, bells []provider.BellPeriod) string {
+	var b strings.Builder
+	writeCalendarHeader(&b)
+	writeDayEvents(&b, day, date, bells)
+	writeCalendarFooter(&b)
+	return b.String()
+}
+
+// Week сериализует Schedule целиком, раскладывая дни недели начиная с
+// понедельника той недели, в которую попадает from (см. weekStart).
+func Week(week *provider.Schedule, from time.Time, bells []provider.BellPeriod) string {
+	var b strings.Builder
+	writeCalendarHeader(&b)
+
+	monday := weekStart(from)
+	for i, day := range *week {
+		writeDayEvents(&b, &day, monday.AddDate(0, 0, i), bells)
+	}
+
+	writeCalendarFooter(&b)
+	return b.String()
+}
+
+func writeCalendarHeader(b *strings.Builder) {
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//SParser//sparser//RU\r\n")
+}
+
+func writeCalendarFooter(b *strings.Builder) {
+	b.WriteString("END:VCALENDAR\r\n")
+}
+
+func writeDayEvents(b *strings.Builder, day *provider.DaySchedule, date time.Time, bells []provider.BellPeriod) {
+	for group, lessons := range *day {
+		for _, lesson := range lessons {
+			start, end, ok := provider.LessonTime(lesson, date, bells)
+			if !ok {
+				continue
+			}
+
+			fmt.Fprintf(b, "BEGIN:VEVENT\r\n")
+			fmt.Fprintf(b, "UID:%s-%s-%d@sparser\r\n", date.Format(time.DateOnly), escapeICSText(group), lesson.Period)
+			fmt.Fprintf(b, "DTSTART:%s\r\n", start.Format(icsDateTimeLayout))
+			fmt.Fprintf(b, "DTEND:%s\r\n", end.Format(icsDateTimeLayout))
+			fmt.Fprintf(b, "SUMMARY:%s (%s)\r\n", escapeICSText(lesson.Name), escapeICSText(group))
+			fmt.Fprintf(b, "LOCATION:%s\r\n", escapeICSText(lesson.Cabinet))
+			fmt.Fprintf(b, "END:VEVENT\r\n")
+		}
+	}
+}
+
+// icsTextEscaper экранирует символы, которые RFC 5545 (3.3.11) запрещает
+// использовать буквально в значениях типа TEXT: обратный слэш, запятую,
+// точку с запятой и перевод строки.
+var icsTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	",", `\,`,
+	";", `\;`,
+	"\n", `\n`,
+	"\r", "",
+)
+
+// escapeICSText экранирует произвольную строку для использования в значении
+// TEXT iCalendar-свойства (SUMMARY, LOCATION, UID), чтобы запятые, точки с
+// запятой и переносы строк в названии урока или кабинета не ломали VEVENT.
+func escapeICSText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
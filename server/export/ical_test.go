@@ -0,0 +1,49 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"splatorm/provider"
+)
+
+func TestWeekPlacesFirstDayOnMondayEvenFromSunday(t *testing.T) {
+	sunday := time.Date(2026, time.July, 26, 12, 0, 0, 0, time.UTC)
+
+	week := provider.Schedule{
+		provider.DaySchedule{"9A": {{Name: "Math", Cabinet: "101", Period: 1}}},
+	}
+	bells := []provider.BellPeriod{{Start: 8 * time.Hour, End: 9 * time.Hour}}
+
+	ics := Week(&week, sunday, bells)
+
+	monday := time.Date(2026, time.July, 20, 0, 0, 0, 0, time.UTC)
+	wantStart := "DTSTART:" + monday.Add(8*time.Hour).Format(icsDateTimeLayout)
+	if !strings.Contains(ics, wantStart) {
+		t.Fatalf("expected %q in output, got:\n%s", wantStart, ics)
+	}
+}
+
+func TestDaySkipsLessonsWithoutABellPeriod(t *testing.T) {
+	day := provider.DaySchedule{"9A": {{Name: "Math", Cabinet: "101", Period: 5}}}
+	bells := []provider.BellPeriod{{Start: 8 * time.Hour, End: 9 * time.Hour}}
+
+	ics := Day(&day, time.Now(), bells)
+	if strings.Contains(ics, "BEGIN:VEVENT") {
+		t.Fatalf("expected no VEVENT for a period outside the bell schedule, got:\n%s", ics)
+	}
+}
+
+func TestDayEscapesTextFieldsWithSpecialCharacters(t *testing.T) {
+	day := provider.DaySchedule{"9A": {{Name: "Math, Part 1; Review\nNotes", Cabinet: "101", Period: 1}}}
+	bells := []provider.BellPeriod{{Start: 8 * time.Hour, End: 9 * time.Hour}}
+
+	ics := Day(&day, time.Now(), bells)
+	if !strings.Contains(ics, `SUMMARY:Math\, Part 1\; Review\nNotes (9A)`) {
+		t.Fatalf("expected SUMMARY to escape commas, semicolons and newlines, got:\n%s", ics)
+	}
+	if strings.Contains(ics, "Review\nNotes") {
+		t.Fatalf("expected the raw newline to not survive into the output, got:\n%s", ics)
+	}
+}
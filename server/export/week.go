@@ -0,0 +1,12 @@
+package export
+
+import "time"
+
+// weekStart возвращает понедельник недели, в которую попадает from —
+// включая случай, когда from сам приходится на воскресенье: time.Weekday
+// нумерует его нулём, так что наивное "-Weekday()+1" сдвигало бы воскресенье
+// на следующую неделю вместо текущей.
+func weekStart(from time.Time) time.Time {
+	offset := (int(from.Weekday()) + 6) % 7
+	return from.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,36 @@
+package export
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekStartHandlesSunday(t *testing.T) {
+	sunday := time.Date(2026, time.July, 26, 12, 0, 0, 0, time.UTC)
+	if sunday.Weekday() != time.Sunday {
+		t.Fatalf("fixture date is not a Sunday: %v", sunday.Weekday())
+	}
+
+	monday := weekStart(sunday)
+	if monday.Weekday() != time.Monday {
+		t.Fatalf("expected Monday, got %v", monday.Weekday())
+	}
+	if sunday.Sub(monday) != 6*24*time.Hour {
+		t.Fatalf("expected the Monday 6 days before %v, got %v", sunday, monday)
+	}
+}
+
+func TestWeekStartHandlesMidweekDay(t *testing.T) {
+	wednesday := time.Date(2026, time.July, 22, 12, 0, 0, 0, time.UTC)
+	if wednesday.Weekday() != time.Wednesday {
+		t.Fatalf("fixture date is not a Wednesday: %v", wednesday.Weekday())
+	}
+
+	monday := weekStart(wednesday)
+	if monday.Weekday() != time.Monday {
+		t.Fatalf("expected Monday, got %v", monday.Weekday())
+	}
+	if wednesday.Sub(monday) != 2*24*time.Hour {
+		t.Fatalf("expected the Monday 2 days before %v, got %v", wednesday, monday)
+	}
+}
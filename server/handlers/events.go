@@ -0,0 +1,67 @@
+// Подписка на изменения расписания (Server-Sent Events)
+
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"splatorm/provider"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// heartbeatInterval — как часто слать пустой комментарий в простаивающий
+// поток, чтобы вовремя заметить закрытое клиентом соединение: ошибка записи
+// в него узнаётся только на Flush, а без heartbeat это могло случиться лишь
+// при следующем ChangeEvent, то есть не раньше следующего опроса расписания.
+const heartbeatInterval = 15 * time.Second
+
+type EventsHandlers struct {
+	Bus *provider.Bus
+}
+
+// GetEvents открывает поток Server-Sent Events и отдаёт в него по одному
+// событию schedule.changed на каждое изменение Status().Hash, со структурным
+// диффом по дням/группам — чтобы ботам поверх SParser не нужно было опрашивать
+// /sc/status.
+func (h EventsHandlers) GetEvents(c fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+
+	events, unsubscribe := h.Bus.Subscribe()
+
+	c.SendStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+
+				payload, err := json.Marshal(ev)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(w, "event: schedule.changed\ndata: %s\n\n", payload)
+
+			case <-ticker.C:
+				fmt.Fprint(w, ": ping\n\n")
+			}
+
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Экспорт расписания в iCalendar и CSV
+
+package handlers
+
+import (
+	"time"
+
+	"splatorm/export"
+	"splatorm/provider"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// ExportHandlers отдаёт расписание в форматах, пригодных для подписки во
+// внешних календарях (Google Calendar, Outlook) и табличных редакторах.
+type ExportHandlers struct {
+	Provider provider.ScheduleProvider
+	Bells    []provider.BellPeriod
+}
+
+func (h ExportHandlers) GetDayICS(c fiber.Ctx) error {
+	day, date, err := h.resolveDay(c)
+	if err != nil {
+		return fiber.NewError(404, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	return c.SendString(export.Day(day, date, h.bells()))
+}
+
+func (h ExportHandlers) GetWeekICS(c fiber.Ctx) error {
+	week, err := h.Provider.Week()
+	if err != nil {
+		return fiber.NewError(500, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	return c.SendString(export.Week(week, time.Now(), h.bells()))
+}
+
+func (h ExportHandlers) GetDayCSV(c fiber.Ctx) error {
+	day, date, err := h.resolveDay(c)
+	if err != nil {
+		return fiber.NewError(404, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	return c.SendString(export.DayCSV(day, date))
+}
+
+func (h ExportHandlers) GetWeekCSV(c fiber.Ctx) error {
+	week, err := h.Provider.Week()
+	if err != nil {
+		return fiber.NewError(500, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	return c.SendString(export.WeekCSV(week, time.Now()))
+}
+
+func (h ExportHandlers) resolveDay(c fiber.Ctx) (*provider.DaySchedule, time.Time, error) {
+	dateParam := c.Params("day")
+	if dateParam == "" {
+		day, err := h.Provider.Today()
+		return day, time.Now(), err
+	}
+
+	date, err := time.Parse(time.DateOnly, dateParam)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	day, err := h.Provider.Day(date)
+	return day, date, err
+}
+
+func (h ExportHandlers) bells() []provider.BellPeriod {
+	if len(h.Bells) == 0 {
+		return provider.DefaultBells
+	}
+	return h.Bells
+}
@@ -0,0 +1,107 @@
+// HAL+JSON представление ресурсов расписания
+
+package handlers
+
+import (
+	"time"
+
+	"splatorm/provider"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// halContentType — медиатип, при котором обработчики отдают гипермедийный
+// envelope вместо обычного JSON.
+const halContentType = "application/hal+json"
+
+type halLink struct {
+	Href string `json:"href"`
+}
+
+type halLinks map[string]halLink
+
+// SendHAL отправляет envelope с заголовком Content-Type: application/hal+json.
+func SendHAL(c fiber.Ctx, envelope any) error {
+	c.Set(fiber.HeaderContentType, halContentType)
+	return c.JSON(envelope)
+}
+
+// wantsHAL решает, какое из двух представлений отдать. c.Accepts ранжирует
+// офферы по качеству и специфичности заголовка Accept и для ничего не
+// говорящих заголовков — пустого, бессодержательного "*/*" (именно это шлют
+// curl и fetch() по умолчанию), или браузерного "...,*/*;q=0.8" — возвращает
+// первый по порядку одинаково подходящий оффер. Поэтому application/json
+// передаётся первым: HAL отдаётся, только если application/hal+json реально
+// выигрывает негоциацию, а не потому что клиент принимает вообще всё.
+func wantsHAL(c fiber.Ctx) bool {
+	return c.Accepts(fiber.MIMEApplicationJSON, halContentType) == halContentType
+}
+
+func dayLinks(date time.Time) halLinks {
+	self := date.Format(time.DateOnly)
+	return halLinks{
+		"self":   {Href: "/sc/" + self},
+		"prev":   {Href: "/sc/" + date.AddDate(0, 0, -1).Format(time.DateOnly)},
+		"next":   {Href: "/sc/" + date.AddDate(0, 0, 1).Format(time.DateOnly)},
+		"today":  {Href: "/sc/today"},
+		"week":   {Href: "/sc/week"},
+		"status": {Href: "/sc/status"},
+	}
+}
+
+func weekLinks() halLinks {
+	return halLinks{
+		"self":   {Href: "/sc/week"},
+		"today":  {Href: "/sc/today"},
+		"status": {Href: "/sc/status"},
+	}
+}
+
+func statusLinks() halLinks {
+	return halLinks{
+		"self": {Href: "/sc/status"},
+		"week": {Href: "/sc/week"},
+	}
+}
+
+// halDay — HAL-envelope для DaySchedule: уроки по группам лежат в
+// _embedded.groups, переходы на соседние дни и неделю — в _links.
+type halDay struct {
+	Links    halLinks `json:"_links"`
+	Embedded struct {
+		Groups provider.DaySchedule `json:"groups"`
+	} `json:"_embedded"`
+}
+
+func newHALDay(date time.Time, day *provider.DaySchedule) halDay {
+	var env halDay
+	env.Links = dayLinks(date)
+	env.Embedded.Groups = *day
+	return env
+}
+
+// halWeek — HAL-envelope для Schedule: дни недели лежат в _embedded.days.
+type halWeek struct {
+	Links    halLinks `json:"_links"`
+	Embedded struct {
+		Days provider.Schedule `json:"days"`
+	} `json:"_embedded"`
+}
+
+func newHALWeek(week *provider.Schedule) halWeek {
+	var env halWeek
+	env.Links = weekLinks()
+	env.Embedded.Days = *week
+	return env
+}
+
+// halStatus — HAL-envelope для ScheduleStatus: поля статуса лежат на верхнем
+// уровне, рядом с _links.
+type halStatus struct {
+	provider.ScheduleStatus
+	Links halLinks `json:"_links"`
+}
+
+func newHALStatus(status provider.ScheduleStatus) halStatus {
+	return halStatus{ScheduleStatus: status, Links: statusLinks()}
+}
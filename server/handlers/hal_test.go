@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestWantsHALRequiresExplicitMediaType(t *testing.T) {
+	cases := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{"no header", "", false},
+		{"bare wildcard", "*/*", false},
+		{"browser-style trailing wildcard", "text/html,application/xhtml+xml,*/*;q=0.8", false},
+		{"explicit json", "application/json", false},
+		{"explicit hal", "application/hal+json", true},
+		{"hal preferred over json", "application/json;q=0.5, application/hal+json;q=0.9", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := fiber.New()
+			var got bool
+			app.Get("/", func(c fiber.Ctx) error {
+				got = wantsHAL(c)
+				return c.SendStatus(fiber.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.accept != "" {
+				req.Header.Set(fiber.HeaderAccept, tc.accept)
+			}
+
+			if _, err := app.Test(req); err != nil {
+				t.Fatalf("app.Test: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("wantsHAL() with Accept=%q = %v, want %v", tc.accept, got, tc.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package handlers
 
 import (
 	"splatorm/provider"
+	"splatorm/utils"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
@@ -26,32 +27,68 @@ func (h ScheduleHandlers) GetDay(c fiber.Ctx) error {
 		}
 	}
 
+	c.Set(fiber.HeaderVary, "Accept")
+	status := h.Provider.Status()
+	if utils.Cache(c, status.Parsed, status.Hash) {
+		return c.SendStatus(304)
+	}
+
 	res, err := h.Provider.Day(day)
 	if err != nil {
 		return fiber.NewError(500, err.Error())
 	}
 
+	if wantsHAL(c) {
+		return SendHAL(c, newHALDay(day, res))
+	}
 	return c.JSON(res)
 }
 
 func (h ScheduleHandlers) GetToday(c fiber.Ctx) error {
+	c.Set(fiber.HeaderVary, "Accept")
+	status := h.Provider.Status()
+	if utils.Cache(c, status.Parsed, status.Hash) {
+		return c.SendStatus(304)
+	}
+
 	res, err := h.Provider.Today()
 	if err != nil {
 		return fiber.NewError(500, err.Error())
 	}
 
+	if wantsHAL(c) {
+		return SendHAL(c, newHALDay(time.Now(), res))
+	}
 	return c.JSON(res)
 }
 
 func (h ScheduleHandlers) GetWeek(c fiber.Ctx) error {
+	c.Set(fiber.HeaderVary, "Accept")
+	status := h.Provider.Status()
+	if utils.Cache(c, status.Parsed, status.Hash) {
+		return c.SendStatus(304)
+	}
+
 	res, err := h.Provider.Week()
 	if err != nil {
 		return fiber.NewError(500, err.Error())
 	}
 
+	if wantsHAL(c) {
+		return SendHAL(c, newHALWeek(res))
+	}
 	return c.JSON(res)
 }
 
 func (h ScheduleHandlers) GetStatus(c fiber.Ctx) error {
-	return c.JSON(h.Provider.Status())
+	c.Set(fiber.HeaderVary, "Accept")
+	status := h.Provider.Status()
+	if utils.Cache(c, status.Parsed, status.Hash) {
+		return c.SendStatus(304)
+	}
+
+	if wantsHAL(c) {
+		return SendHAL(c, newHALStatus(status))
+	}
+	return c.JSON(status)
 }
@@ -0,0 +1,247 @@
+// Провайдер, сводящий несколько источников расписания в один
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AggregateProvider опрашивает несколько Fetcher'ов с заданным интервалом и
+// сводит их снимки в один Week() по precedence: источники идут от низшего к
+// высшему приоритету, и совпадающие группы у более приоритетного источника
+// перекрывают те же группы у менее приоритетного (например, основное
+// расписание + лист замен поверх него). Итоговый снимок кэшируется на диске
+// и рассылается подписчикам через Bus при изменении хэша.
+type AggregateProvider struct {
+	cfg      Config
+	fetchers []Fetcher
+	cache    *DiskCache
+	bus      *Bus
+
+	mu     sync.RWMutex
+	week   Schedule
+	status ScheduleStatus
+}
+
+// NewAggregateProvider собирает провайдера из источников, упорядоченных по
+// возрастанию приоритета, восстанавливает последний кэш и запускает фоновый
+// опрос с интервалом cfg.PollInterval.
+func NewAggregateProvider(cfg Config, cache *DiskCache, fetchers ...Fetcher) *AggregateProvider {
+	p := &AggregateProvider{
+		cfg:      cfg,
+		fetchers: fetchers,
+		cache:    cache,
+		bus:      NewBus(),
+	}
+
+	if week, status, err := cache.Load(); err == nil {
+		p.week, p.status = week, status
+	} else {
+		log.Printf("provider: нет валидного кэша: %v", err)
+	}
+
+	go p.pollLoop()
+
+	return p
+}
+
+func (p *AggregateProvider) pollLoop() {
+	p.refresh()
+
+	ticker := time.NewTicker(p.cfg.PollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		p.refresh()
+	}
+}
+
+// refresh опрашивает все источники и, если итоговый хэш изменился, атомарно
+// обновляет снимок, перезаписывает кэш на диске и публикует дифф в Bus/
+// вебхуки. Если хотя бы один источник недоступен, оставляет в силе последний
+// закэшированный снимок целиком, а не частично обновлённый.
+func (p *AggregateProvider) refresh() {
+	week, err := p.fetch()
+	if err != nil {
+		log.Printf("provider: опрос источников не удался, используем кэш: %v", err)
+		return
+	}
+
+	hash := hashSchedule(week)
+
+	p.mu.RLock()
+	prevWeek, prevStatus := p.week, p.status
+	p.mu.RUnlock()
+	if hash == prevStatus.Hash {
+		return
+	}
+
+	status := ScheduleStatus{Parsed: time.Now(), Hash: hash}
+
+	p.mu.Lock()
+	p.week = week
+	p.status = status
+	p.mu.Unlock()
+
+	if err := p.cache.Save(week, status); err != nil {
+		log.Printf("provider: не удалось сохранить кэш: %v", err)
+	}
+
+	ev := ChangeEvent{
+		Status: status,
+		Diff: ScheduleDiff{
+			PrevHash: prevStatus.Hash,
+			NextHash: status.Hash,
+			Days:     diffSchedule(prevWeek, week),
+		},
+	}
+	p.bus.Publish(ev)
+	p.notifyWebhooks(ev)
+}
+
+func (p *AggregateProvider) fetch() (Schedule, error) {
+	if len(p.fetchers) == 0 {
+		return nil, fmt.Errorf("нет ни одного настроенного источника")
+	}
+
+	ctx := context.Background()
+
+	schedules := make([]Schedule, 0, len(p.fetchers))
+	for _, f := range p.fetchers {
+		week, err := f.Fetch(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name(), err)
+		}
+		schedules = append(schedules, week)
+	}
+	return mergeWeek(schedules), nil
+}
+
+// mergeWeek сводит снимки нескольких источников в один, по дням недели.
+// schedules должны быть упорядочены по возрастанию приоритета.
+func mergeWeek(schedules []Schedule) Schedule {
+	days := 0
+	for _, s := range schedules {
+		if len(s) > days {
+			days = len(s)
+		}
+	}
+
+	week := make(Schedule, days)
+	for i := 0; i < days; i++ {
+		day := make(DaySchedule)
+		for _, s := range schedules {
+			if i < len(s) {
+				day = mergeDay(day, s[i])
+			}
+		}
+		week[i] = day
+	}
+	return week
+}
+
+// mergeDay накладывает overlay поверх base: группы из overlay полностью
+// заменяют одноимённые группы из base, остальные группы base сохраняются.
+func mergeDay(base, overlay DaySchedule) DaySchedule {
+	merged := make(DaySchedule, len(base)+len(overlay))
+	for g, lessons := range base {
+		merged[g] = lessons
+	}
+	for g, lessons := range overlay {
+		merged[g] = lessons
+	}
+	return merged
+}
+
+// Bus отдаёт шину событий изменения расписания, чтобы обработчики могли
+// подписывать на неё SSE-соединения.
+func (p *AggregateProvider) Bus() *Bus {
+	return p.bus
+}
+
+// Bells отдаёт сетку звонков из конфигурации, чтобы экспорт в iCalendar мог
+// переводить Period уроков в абсолютное время. Пустая сетка (SP_BELLS не
+// задан) — валидное значение, экспорт сам подставляет DefaultBells.
+func (p *AggregateProvider) Bells() []BellPeriod {
+	return p.cfg.Bells
+}
+
+// webhookTimeout ограничивает время, которое зависший или недоступный
+// вебхук-получатель может удерживать горутину notifyWebhooks.
+const webhookTimeout = 10 * time.Second
+
+// webhookClient рассылает уведомления о вебхуках с ограничением по времени,
+// чтобы медленные получатели не копили горутины от опроса к опросу.
+var webhookClient = &http.Client{Timeout: webhookTimeout}
+
+// notifyWebhooks рассылает событие на все URL из cfg.Webhooks, эхом повторяя
+// заголовок X-Request-Source для получателей, различающих источники событий.
+func (p *AggregateProvider) notifyWebhooks(ev ChangeEvent) {
+	if len(p.cfg.Webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		log.Printf("provider: не удалось сериализовать событие для вебхуков: %v", err)
+		return
+	}
+
+	for _, url := range p.cfg.Webhooks {
+		go func(url string) {
+			ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+			defer cancel()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+			if err != nil {
+				log.Printf("provider: вебхук %s: %v", url, err)
+				return
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Request-Source", "sparser")
+
+			resp, err := webhookClient.Do(req)
+			if err != nil {
+				log.Printf("provider: вебхук %s не доставлен: %v", url, err)
+				return
+			}
+			resp.Body.Close()
+		}(url)
+	}
+}
+
+func (p *AggregateProvider) Day(day time.Time) (*DaySchedule, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	idx := int(day.Weekday()) - 1
+	if idx < 0 || idx >= len(p.week) {
+		return nil, fmt.Errorf("нет расписания на %s", day.Format(time.DateOnly))
+	}
+	return &p.week[idx], nil
+}
+
+func (p *AggregateProvider) Today() (*DaySchedule, error) {
+	return p.Day(time.Now())
+}
+
+func (p *AggregateProvider) Week() (*Schedule, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	week := p.week
+	return &week, nil
+}
+
+func (p *AggregateProvider) Status() ScheduleStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.status
+}
@@ -0,0 +1,44 @@
+package provider
+
+import "testing"
+
+func TestMergeDayOverlayWins(t *testing.T) {
+	base := DaySchedule{
+		"9A": {{Name: "Math", Period: 1}},
+		"9B": {{Name: "History", Period: 1}},
+	}
+	overlay := DaySchedule{
+		"9A": {{Name: "Substitute PE", Period: 1}},
+	}
+
+	merged := mergeDay(base, overlay)
+
+	if len(merged["9A"]) != 1 || merged["9A"][0].Name != "Substitute PE" {
+		t.Fatalf("expected overlay to win for 9A, got %+v", merged["9A"])
+	}
+	if len(merged["9B"]) != 1 || merged["9B"][0].Name != "History" {
+		t.Fatalf("expected base group 9B to be preserved, got %+v", merged["9B"])
+	}
+}
+
+func TestMergeWeekPadsShorterSchedules(t *testing.T) {
+	base := Schedule{
+		DaySchedule{"9A": {{Name: "Math", Period: 1}}},
+		DaySchedule{"9A": {{Name: "PE", Period: 1}}},
+	}
+	overlay := Schedule{
+		DaySchedule{"9A": {{Name: "Substitute", Period: 1}}},
+	}
+
+	merged := mergeWeek([]Schedule{base, overlay})
+
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 days, got %d", len(merged))
+	}
+	if merged[0]["9A"][0].Name != "Substitute" {
+		t.Fatalf("expected overlay to win on day 0, got %+v", merged[0]["9A"])
+	}
+	if merged[1]["9A"][0].Name != "PE" {
+		t.Fatalf("expected base preserved on day 1 (overlay has no day 1), got %+v", merged[1]["9A"])
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BellPeriod задаёт время начала и окончания одного урока, как смещение от
+// начала суток.
+type BellPeriod struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// DefaultBells — сетка звонков по умолчанию, используется экспортом в
+// iCalendar, пока в конфигурации не задана своя.
+var DefaultBells = []BellPeriod{
+	{Start: 8*time.Hour + 30*time.Minute, End: 9*time.Hour + 15*time.Minute},
+	{Start: 9*time.Hour + 25*time.Minute, End: 10*time.Hour + 10*time.Minute},
+	{Start: 10*time.Hour + 30*time.Minute, End: 11*time.Hour + 15*time.Minute},
+	{Start: 11*time.Hour + 35*time.Minute, End: 12*time.Hour + 20*time.Minute},
+	{Start: 12*time.Hour + 40*time.Minute, End: 13*time.Hour + 25*time.Minute},
+	{Start: 13*time.Hour + 35*time.Minute, End: 14*time.Hour + 20*time.Minute},
+	{Start: 14*time.Hour + 30*time.Minute, End: 15*time.Hour + 15*time.Minute},
+}
+
+// At возвращает абсолютные начало и конец урока в указанный день.
+func (b BellPeriod) At(day time.Time) (start, end time.Time) {
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	return midnight.Add(b.Start), midnight.Add(b.End)
+}
+
+// LessonTime возвращает абсолютные начало и конец урока в указанный день по
+// его Period и переданной сетке звонков. ok=false, если Period вне сетки.
+func LessonTime(lesson Lesson, day time.Time, bells []BellPeriod) (start, end time.Time, ok bool) {
+	idx := lesson.Period - 1
+	if idx < 0 || idx >= len(bells) {
+		return time.Time{}, time.Time{}, false
+	}
+	start, end = bells[idx].At(day)
+	return start, end, true
+}
+
+// ParseBells разбирает сетку звонков из строки вида "08:30-09:15,09:25-10:10"
+// (см. SP_BELLS в ConfigFromEnv). Периоды идут через запятую, начало и конец
+// каждого — через дефис, в формате HH:MM.
+func ParseBells(raw string) ([]BellPeriod, error) {
+	var bells []BellPeriod
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		start, end, found := strings.Cut(part, "-")
+		if !found {
+			return nil, fmt.Errorf("provider: invalid bell period %q: want HH:MM-HH:MM", part)
+		}
+
+		startOffset, err := parseClock(start)
+		if err != nil {
+			return nil, fmt.Errorf("provider: invalid bell period %q: %w", part, err)
+		}
+		endOffset, err := parseClock(end)
+		if err != nil {
+			return nil, fmt.Errorf("provider: invalid bell period %q: %w", part, err)
+		}
+
+		bells = append(bells, BellPeriod{Start: startOffset, End: endOffset})
+	}
+	return bells, nil
+}
+
+// parseClock разбирает время суток в формате HH:MM в смещение от полуночи.
+func parseClock(clock string) (time.Duration, error) {
+	hours, minutes, found := strings.Cut(clock, ":")
+	if !found {
+		return 0, fmt.Errorf("want HH:MM, got %q", clock)
+	}
+
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, fmt.Errorf("want HH:MM, got %q", clock)
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, fmt.Errorf("want HH:MM, got %q", clock)
+	}
+
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBellsParsesStartAndEnd(t *testing.T) {
+	bells, err := ParseBells("08:30-09:15,09:25-10:10")
+	if err != nil {
+		t.Fatalf("ParseBells: %v", err)
+	}
+	if len(bells) != 2 {
+		t.Fatalf("expected 2 periods, got %d", len(bells))
+	}
+
+	want := BellPeriod{Start: 8*time.Hour + 30*time.Minute, End: 9*time.Hour + 15*time.Minute}
+	if bells[0] != want {
+		t.Fatalf("first period = %+v, want %+v", bells[0], want)
+	}
+}
+
+func TestParseBellsRejectsMalformedPeriod(t *testing.T) {
+	if _, err := ParseBells("08:30"); err == nil {
+		t.Fatal("expected an error for a period without a dash")
+	}
+	if _, err := ParseBells("08-09:00"); err == nil {
+		t.Fatal("expected an error for a clock without minutes")
+	}
+}
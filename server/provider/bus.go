@@ -0,0 +1,57 @@
+// Шина подписки на изменения расписания
+
+package provider
+
+import "sync"
+
+// ChangeEvent — событие о том, что расписание обновилось: новый статус и
+// структурный дифф относительно предыдущего снимка.
+type ChangeEvent struct {
+	Status ScheduleStatus `json:"status"`
+	Diff   ScheduleDiff   `json:"diff"`
+}
+
+// Bus рассылает события об изменении расписания подписчикам, взявшим канал
+// через Subscribe (открытым SSE-соединениям). Исходящие вебхуки через Bus не
+// идут: их рассылает AggregateProvider.notifyWebhooks.
+type Bus struct {
+	mu   sync.Mutex
+	subs map[chan ChangeEvent]struct{}
+}
+
+// NewBus создаёт пустую шину без подписчиков.
+func NewBus() *Bus {
+	return &Bus{subs: make(map[chan ChangeEvent]struct{})}
+}
+
+// Subscribe возвращает канал с событиями и функцию отписки, которую нужно
+// вызвать по завершении слушателя.
+func (b *Bus) Subscribe() (<-chan ChangeEvent, func()) {
+	ch := make(chan ChangeEvent, 8)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		delete(b.subs, ch)
+		b.mu.Unlock()
+		close(ch)
+	}
+	return ch, unsubscribe
+}
+
+// Publish рассылает событие всем текущим подписчикам. Подписчик с
+// переполненным каналом событие пропускает, а не блокирует остальных.
+func (b *Bus) Publish(ev ChangeEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DiskCache хранит разобранные снимки расписания на диске, по одному файлу
+// на хэш содержимого, плюс небольшой указатель на то, какой снимок актуален.
+// Старые файлы по неактуальным хэшам не вычищаются намеренно — это дёшево и
+// даёт историю снимков для отладки.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache создаёt кэш в каталоге dir, создавая его при необходимости.
+func NewDiskCache(dir string) *DiskCache {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "provider: не удалось создать %s: %v\n", dir, err)
+	}
+	return &DiskCache{dir: dir}
+}
+
+func (c *DiskCache) pointerPath() string {
+	return filepath.Join(c.dir, "status.json")
+}
+
+func (c *DiskCache) snapshotPath(hash string) string {
+	return filepath.Join(c.dir, hash+".json")
+}
+
+// Load восстанавливает последний сохранённый снимок, если он есть.
+func (c *DiskCache) Load() (Schedule, ScheduleStatus, error) {
+	var status ScheduleStatus
+
+	raw, err := os.ReadFile(c.pointerPath())
+	if err != nil {
+		return nil, status, err
+	}
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return nil, status, err
+	}
+
+	raw, err = os.ReadFile(c.snapshotPath(status.Hash))
+	if err != nil {
+		return nil, status, err
+	}
+
+	var week Schedule
+	if err := json.Unmarshal(raw, &week); err != nil {
+		return nil, status, err
+	}
+	return week, status, nil
+}
+
+// Save записывает снимок в файл, поименованный по его хэшу, и переставляет
+// указатель status.json на него.
+func (c *DiskCache) Save(week Schedule, status ScheduleStatus) error {
+	raw, err := json.Marshal(week)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.snapshotPath(status.Hash), raw, 0o644); err != nil {
+		return err
+	}
+
+	raw, err = json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.pointerPath(), raw, 0o644)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiskCacheSaveLoad(t *testing.T) {
+	cache := NewDiskCache(t.TempDir())
+
+	week := Schedule{DaySchedule{"9A": {{Name: "Math", Cabinet: "101", Period: 1}}}}
+	status := ScheduleStatus{Parsed: time.Now().Truncate(time.Second), Hash: "abc123"}
+
+	if err := cache.Save(week, status); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	gotWeek, gotStatus, err := cache.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if gotStatus.Hash != status.Hash || !gotStatus.Parsed.Equal(status.Parsed) {
+		t.Fatalf("status mismatch: got %+v, want %+v", gotStatus, status)
+	}
+	if len(gotWeek) != 1 || gotWeek[0]["9A"][0].Name != "Math" {
+		t.Fatalf("week mismatch: %+v", gotWeek)
+	}
+}
+
+func TestDiskCacheLoadMissing(t *testing.T) {
+	cache := NewDiskCache(t.TempDir())
+
+	if _, _, err := cache.Load(); err == nil {
+		t.Fatal("expected an error loading from an empty cache directory")
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultPollInterval используется, когда SP_POLL_INTERVAL не задан.
+const defaultPollInterval = 5 * time.Minute
+
+// Config собирает настройки источников расписания: какую таблицу и какие
+// диапазоны опрашивать и с каким интервалом.
+type Config struct {
+	SheetID       string
+	Ranges        []string
+	PollInterval  time.Duration
+	Webhooks      []string
+	OverridesPath string
+	Bells         []BellPeriod
+}
+
+// ConfigFromEnv читает конфигурацию из переменных окружения, подставляя
+// разумные значения по умолчанию там, где это возможно:
+//
+//	SP_SHEET_ID      — идентификатор Google-таблицы
+//	SP_RANGES        — диапазоны листов через запятую, например "Mon!A1:C20,Tue!A1:C20"
+//	SP_POLL_INTERVAL — интервал опроса в секундах
+//	SP_WEBHOOKS      — URL-адреса для уведомлений об изменениях через запятую
+//	SP_OVERRIDES     — путь к файлу ежедневных переопределений (необязательно)
+//	SP_BELLS         — сетка звонков через запятую, например "08:30-09:15,09:25-10:10"
+//	                   (необязательно, иначе используется provider.DefaultBells)
+func ConfigFromEnv() Config {
+	cfg := Config{
+		SheetID:      os.Getenv("SP_SHEET_ID"),
+		PollInterval: defaultPollInterval,
+	}
+
+	if raw := os.Getenv("SP_RANGES"); raw != "" {
+		for _, r := range strings.Split(raw, ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				cfg.Ranges = append(cfg.Ranges, r)
+			}
+		}
+	}
+
+	if raw := os.Getenv("SP_POLL_INTERVAL"); raw != "" {
+		if secs, err := strconv.Atoi(raw); err == nil && secs > 0 {
+			cfg.PollInterval = time.Duration(secs) * time.Second
+		}
+	}
+
+	if raw := os.Getenv("SP_WEBHOOKS"); raw != "" {
+		for _, u := range strings.Split(raw, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				cfg.Webhooks = append(cfg.Webhooks, u)
+			}
+		}
+	}
+
+	cfg.OverridesPath = os.Getenv("SP_OVERRIDES")
+
+	if raw := os.Getenv("SP_BELLS"); raw != "" {
+		bells, err := ParseBells(raw)
+		if err != nil {
+			log.Printf("provider: SP_BELLS проигнорирован, используем сетку по умолчанию: %v", err)
+		} else {
+			cfg.Bells = bells
+		}
+	}
+
+	return cfg
+}
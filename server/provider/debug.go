@@ -10,6 +10,10 @@ func (p DebugProvider) Day(day time.Time) (*DaySchedule, error) {
 	return &sc, nil
 }
 
+func (p DebugProvider) Today() (*DaySchedule, error) {
+	return p.Day(time.Now())
+}
+
 func (p DebugProvider) Week() (*Schedule, error) {
 	var sc Schedule
 	sc = make(Schedule, 1)
@@ -0,0 +1,88 @@
+// Структурный дифф расписания между двумя опросами
+
+package provider
+
+// LessonDiff — изменения в списке уроков одной группы: какие уроки
+// появились, какие пропали.
+type LessonDiff struct {
+	Added   []Lesson `json:"added,omitempty"`
+	Removed []Lesson `json:"removed,omitempty"`
+}
+
+// DayDiff — изменения в расписании одного дня, по группам/классам.
+type DayDiff map[string]LessonDiff
+
+// ScheduleDiff — изменения между двумя снимками расписания.
+type ScheduleDiff struct {
+	PrevHash string    `json:"prev_hash"`
+	NextHash string    `json:"next_hash"`
+	Days     []DayDiff `json:"days"`
+}
+
+// diffSchedule сравнивает два снимка расписания по дням недели и возвращает,
+// какие уроки добавились или пропали в каждой группе.
+func diffSchedule(prev, next Schedule) []DayDiff {
+	n := len(next)
+	if len(prev) > n {
+		n = len(prev)
+	}
+
+	days := make([]DayDiff, n)
+	for i := 0; i < n; i++ {
+		var prevDay, nextDay DaySchedule
+		if i < len(prev) {
+			prevDay = prev[i]
+		}
+		if i < len(next) {
+			nextDay = next[i]
+		}
+		days[i] = diffDay(prevDay, nextDay)
+	}
+	return days
+}
+
+func diffDay(prev, next DaySchedule) DayDiff {
+	diff := make(DayDiff)
+
+	groups := make(map[string]struct{}, len(prev)+len(next))
+	for g := range prev {
+		groups[g] = struct{}{}
+	}
+	for g := range next {
+		groups[g] = struct{}{}
+	}
+
+	for g := range groups {
+		added, removed := diffLessons(prev[g], next[g])
+		if len(added) > 0 || len(removed) > 0 {
+			diff[g] = LessonDiff{Added: added, Removed: removed}
+		}
+	}
+	return diff
+}
+
+// diffLessons сравнивает списки уроков как мультимножества, так что
+// перестановка одних и тех же уроков не считается изменением.
+func diffLessons(prev, next []Lesson) (added, removed []Lesson) {
+	prevCount := make(map[Lesson]int, len(prev))
+	for _, l := range prev {
+		prevCount[l]++
+	}
+
+	nextCount := make(map[Lesson]int, len(next))
+	for _, l := range next {
+		nextCount[l]++
+	}
+
+	for l, n := range nextCount {
+		for i := prevCount[l]; i < n; i++ {
+			added = append(added, l)
+		}
+	}
+	for l, n := range prevCount {
+		for i := nextCount[l]; i < n; i++ {
+			removed = append(removed, l)
+		}
+	}
+	return added, removed
+}
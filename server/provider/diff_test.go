@@ -0,0 +1,45 @@
+package provider
+
+import "testing"
+
+func TestDiffLessonsAddedRemoved(t *testing.T) {
+	prev := []Lesson{{Name: "Math", Period: 1}, {Name: "History", Period: 2}}
+	next := []Lesson{{Name: "Math", Period: 1}, {Name: "Physics", Period: 2}}
+
+	added, removed := diffLessons(prev, next)
+
+	if len(added) != 1 || added[0].Name != "Physics" {
+		t.Fatalf("expected Physics added, got %+v", added)
+	}
+	if len(removed) != 1 || removed[0].Name != "History" {
+		t.Fatalf("expected History removed, got %+v", removed)
+	}
+}
+
+func TestDiffScheduleDetectsGroupChange(t *testing.T) {
+	prev := Schedule{DaySchedule{"9A": {{Name: "Math", Period: 1}}}}
+	next := Schedule{DaySchedule{"9A": {{Name: "Physics", Period: 1}}}}
+
+	days := diffSchedule(prev, next)
+	if len(days) != 1 {
+		t.Fatalf("expected 1 day, got %d", len(days))
+	}
+
+	diff, ok := days[0]["9A"]
+	if !ok {
+		t.Fatal("expected a diff entry for group 9A")
+	}
+	if len(diff.Added) != 1 || len(diff.Removed) != 1 {
+		t.Fatalf("expected one added and one removed lesson, got %+v", diff)
+	}
+}
+
+func TestDiffScheduleNoChange(t *testing.T) {
+	week := Schedule{DaySchedule{"9A": {{Name: "Math", Period: 1}}}}
+
+	for _, diff := range diffSchedule(week, week) {
+		if len(diff) != 0 {
+			t.Fatalf("expected no diff for an unchanged schedule, got %+v", diff)
+		}
+	}
+}
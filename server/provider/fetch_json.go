@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// JSONFetcher читает снимок расписания из локального JSON-файла — например,
+// ad-hoc файла ежедневных переопределений (замен), который правится вручную
+// рядом с основной Google-таблицей.
+type JSONFetcher struct {
+	path string
+}
+
+// NewJSONFetcher возвращает источник, читающий Schedule из path при каждом
+// Fetch — так правки файла подхватываются на следующем опросе без рестарта.
+func NewJSONFetcher(path string) *JSONFetcher {
+	return &JSONFetcher{path: path}
+}
+
+func (f *JSONFetcher) Name() string {
+	return "json:" + f.path
+}
+
+func (f *JSONFetcher) Fetch(ctx context.Context) (Schedule, error) {
+	raw, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.path, err)
+	}
+
+	var week Schedule
+	if err := json.Unmarshal(raw, &week); err != nil {
+		return nil, fmt.Errorf("%s: %w", f.path, err)
+	}
+	return week, nil
+}
@@ -0,0 +1,13 @@
+package provider
+
+import "context"
+
+// Fetcher забирает сырой недельный снимок расписания от одного источника.
+// В пакете есть две реализации: SheetsFetcher (Google Таблица) и JSONFetcher
+// (локальный JSON-файл); источников XLSX, HTTP и iCal среди них нет.
+// AggregateProvider опрашивает несколько Fetcher'ов и сводит их снимки в один.
+type Fetcher interface {
+	// Name используется в сообщениях об ошибках и в логах опроса.
+	Name() string
+	Fetch(ctx context.Context) (Schedule, error)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+)
+
+// sep — разделитель полей при сериализации в hashSchedule. Он не может
+// встретиться в значениях полей, поэтому "AB"+"C" и "A"+"BC" дают разные
+// хэши, а не коллизию на границе полей.
+const sep = "\x00"
+
+// hashSchedule считает стабильный хэш содержимого расписания: ключи
+// сортируются перед сериализацией, чтобы одинаковое содержимое, отданное
+// источником в другом порядке строк, не считалось изменением.
+func hashSchedule(week Schedule) string {
+	h := sha256.New()
+	for _, day := range week {
+		groups := make([]string, 0, len(day))
+		for g := range day {
+			groups = append(groups, g)
+		}
+		sort.Strings(groups)
+
+		for _, g := range groups {
+			h.Write([]byte(g))
+			h.Write([]byte(sep))
+			for _, lesson := range day[g] {
+				h.Write([]byte(lesson.Name))
+				h.Write([]byte(sep))
+				h.Write([]byte(lesson.Cabinet))
+				h.Write([]byte(sep))
+				h.Write([]byte(strconv.Itoa(lesson.Period)))
+				h.Write([]byte(sep))
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
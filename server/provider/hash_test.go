@@ -0,0 +1,36 @@
+package provider
+
+import "testing"
+
+func TestHashScheduleStableAcrossGroupOrder(t *testing.T) {
+	a := Schedule{DaySchedule{
+		"9A": {{Name: "Math", Cabinet: "101", Period: 1}},
+		"9B": {{Name: "History", Cabinet: "102", Period: 1}},
+	}}
+	b := Schedule{DaySchedule{
+		"9B": {{Name: "History", Cabinet: "102", Period: 1}},
+		"9A": {{Name: "Math", Cabinet: "101", Period: 1}},
+	}}
+
+	if hashSchedule(a) != hashSchedule(b) {
+		t.Fatal("hash must not depend on map iteration order")
+	}
+}
+
+func TestHashScheduleChangesOnContent(t *testing.T) {
+	a := Schedule{DaySchedule{"9A": {{Name: "Math", Cabinet: "101", Period: 1}}}}
+	b := Schedule{DaySchedule{"9A": {{Name: "Math", Cabinet: "102", Period: 1}}}}
+
+	if hashSchedule(a) == hashSchedule(b) {
+		t.Fatal("hash must change when cabinet changes")
+	}
+}
+
+func TestHashScheduleDoesNotCollideAcrossFieldBoundary(t *testing.T) {
+	a := Schedule{DaySchedule{"9A": {{Name: "AB", Cabinet: "C", Period: 1}}}}
+	b := Schedule{DaySchedule{"9A": {{Name: "A", Cabinet: "BC", Period: 1}}}}
+
+	if hashSchedule(a) == hashSchedule(b) {
+		t.Fatal("hash must not collide when a field boundary shifts")
+	}
+}
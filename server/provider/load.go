@@ -0,0 +1,26 @@
+package provider
+
+import "log"
+
+// LoadFrom собирает провайдера по умолчанию: Google Таблица как основной
+// источник и, если задан SP_OVERRIDES, локальный файл ежедневных
+// переопределений поверх неё. Снимки кэшируются в cacheDir.
+func LoadFrom(cacheDir string) *AggregateProvider {
+	cfg := ConfigFromEnv()
+	cache := NewDiskCache(cacheDir)
+
+	fetchers := make([]Fetcher, 0, 2)
+
+	sheetsFetcher, err := NewSheetsFetcher(cfg)
+	if err != nil {
+		log.Printf("provider: Sheets API недоступен, работаем по кэшу: %v", err)
+	} else {
+		fetchers = append(fetchers, sheetsFetcher)
+	}
+
+	if cfg.OverridesPath != "" {
+		fetchers = append(fetchers, NewJSONFetcher(cfg.OverridesPath))
+	}
+
+	return NewAggregateProvider(cfg, cache, fetchers...)
+}
@@ -5,6 +5,9 @@ import "time"
 type Lesson struct {
 	Name    string
 	Cabinet string
+	// Period — номер урока по счёту за день (с единицы), по которому
+	// определяется время начала/конца через BellPeriod.
+	Period int
 }
 
 type DaySchedule map[string][]Lesson
@@ -17,6 +20,7 @@ type ScheduleStatus struct {
 
 type ScheduleProvider interface {
 	Day(day time.Time) (*DaySchedule, error)
+	Today() (*DaySchedule, error)
 	Week() (*Schedule, error)
 	Status() ScheduleStatus
 }
@@ -0,0 +1,88 @@
+// Источник расписания поверх Google Таблиц
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/sheets/v4"
+)
+
+// SheetsFetcher читает диапазоны листов одной Google Таблицы и разбирает их
+// в Schedule. Реализует Fetcher, так что AggregateProvider может сочетать его
+// с другими источниками.
+type SheetsFetcher struct {
+	cfg Config
+	svc *sheets.Service
+}
+
+// NewSheetsFetcher поднимает клиент Sheets API по умолчанию (Application
+// Default Credentials) и возвращает источник, готовый к Fetch.
+func NewSheetsFetcher(cfg Config) (*SheetsFetcher, error) {
+	svc, err := sheets.NewService(context.Background(), option.WithScopes(sheets.SpreadsheetsReadonlyScope))
+	if err != nil {
+		return nil, fmt.Errorf("sheets: %w", err)
+	}
+	return &SheetsFetcher{cfg: cfg, svc: svc}, nil
+}
+
+func (f *SheetsFetcher) Name() string {
+	return "sheets:" + f.cfg.SheetID
+}
+
+func (f *SheetsFetcher) Fetch(ctx context.Context) (Schedule, error) {
+	week := make(Schedule, 0, len(f.cfg.Ranges))
+	for _, r := range f.cfg.Ranges {
+		resp, err := f.svc.Spreadsheets.Values.Get(f.cfg.SheetID, r).
+			ValueRenderOption("FORMATTED_VALUE").
+			Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r, err)
+		}
+		week = append(week, parseDayRange(resp.Values))
+	}
+	return week, nil
+}
+
+// parseDayRange раскладывает сырые строки диапазона в DaySchedule, группируя
+// уроки по названию класса/группы из первой колонки строки.
+func parseDayRange(rows [][]any) DaySchedule {
+	day := make(DaySchedule)
+	for _, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+
+		group := strings.TrimSpace(toString(row[0]))
+		if group == "" {
+			continue
+		}
+
+		lesson := Lesson{Name: strings.TrimSpace(toString(row[1]))}
+		if len(row) > 2 {
+			lesson.Cabinet = strings.TrimSpace(toString(row[2]))
+		}
+		lesson.Period = len(day[group]) + 1
+		day[group] = append(day[group], lesson)
+	}
+	return day
+}
+
+// toString превращает ячейку диапазона в строку. С ValueRenderOption
+// FORMATTED_VALUE API всегда отдаёт string, но на случай его отсутствия
+// числовые ячейки (например, кабинет "204", введённый как число) тоже
+// распознаются, а не тихо превращаются в пустую строку.
+func toString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,36 @@
+// Заголовки HTTP-кэширования
+
+package utils
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Cache выставляет Last-Modified, ETag и Cache-Control по времени разбора и
+// хэшу расписания, и сообщает, отвечает ли клиент тем же ETag/датой — в этом
+// случае обработчику достаточно вернуть 304 без тела.
+func Cache(c fiber.Ctx, parsed time.Time, hash string) bool {
+	etag := `"` + hash + `"`
+	lastModified := parsed.UTC().Format(http.TimeFormat)
+
+	c.Set(fiber.HeaderLastModified, lastModified)
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+
+	if match := c.Get(fiber.HeaderIfNoneMatch); match != "" {
+		return match == etag
+	}
+
+	if since := c.Get(fiber.HeaderIfModifiedSince); since != "" {
+		if t, err := http.ParseTime(since); err == nil {
+			// Last-Modified передаётся с точностью до секунды, поэтому и
+			// parsed сравниваем с той же точностью.
+			return !parsed.Truncate(time.Second).After(t)
+		}
+	}
+
+	return false
+}
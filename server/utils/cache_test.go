@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestCacheReturnsNotModifiedOnMatchingETag(t *testing.T) {
+	app := fiber.New()
+	parsed := time.Now().Truncate(time.Second)
+	hash := "deadbeef"
+
+	app.Get("/", func(c fiber.Ctx) error {
+		if Cache(c, parsed, hash) {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+		return c.SendString("fresh")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(fiber.HeaderIfNoneMatch, `"`+hash+`"`)
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusNotModified {
+		t.Fatalf("expected %d, got %d", fiber.StatusNotModified, resp.StatusCode)
+	}
+}
+
+func TestCacheServesFreshOnETagMismatch(t *testing.T) {
+	app := fiber.New()
+	parsed := time.Now().Truncate(time.Second)
+
+	app.Get("/", func(c fiber.Ctx) error {
+		if Cache(c, parsed, "current-hash") {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+		return c.SendString("fresh")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(fiber.HeaderIfNoneMatch, `"stale-hash"`)
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("expected %d, got %d", fiber.StatusOK, resp.StatusCode)
+	}
+	if got := resp.Header.Get(fiber.HeaderETag); got != `"current-hash"` {
+		t.Fatalf("expected ETag header to be set, got %q", got)
+	}
+}
+
+func TestCacheReturnsNotModifiedOnIfModifiedSince(t *testing.T) {
+	app := fiber.New()
+	parsed := time.Now().Truncate(time.Second)
+
+	app.Get("/", func(c fiber.Ctx) error {
+		if Cache(c, parsed, "hash") {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+		return c.SendString("fresh")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(fiber.HeaderIfModifiedSince, parsed.UTC().Format(http.TimeFormat))
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusNotModified {
+		t.Fatalf("expected %d, got %d", fiber.StatusNotModified, resp.StatusCode)
+	}
+}